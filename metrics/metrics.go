@@ -0,0 +1,119 @@
+// Package metrics exposes Prometheus instrumentation for the relay fan-outs
+// performed by the server package. It is intentionally dependency-free of
+// server so that it can be imported from the admin HTTP server as well as
+// from every handler without creating an import cycle.
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "mevboost"
+
+var (
+	// RelayRequestCount counts every request mev-boost makes to a relay, per
+	// relay and fan-out stage (e.g. "get_header", "get_payload").
+	RelayRequestCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "relay_requests_total",
+		Help:      "Number of requests made to a relay, by relay and stage.",
+	}, []string{"relay", "stage"})
+
+	// RelayErrorCount counts failed relay requests, per relay and stage.
+	RelayErrorCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "relay_errors_total",
+		Help:      "Number of failed requests to a relay, by relay and stage.",
+	}, []string{"relay", "stage"})
+
+	// RelayResponseTime tracks how long relay requests take, per relay and stage.
+	RelayResponseTime = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "relay_response_time_seconds",
+		Help:      "Response time of relay requests, by relay and stage.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"relay", "stage"})
+
+	// RelayBidValue tracks the distribution of bid values received from a relay, in wei.
+	RelayBidValue = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "relay_bid_value_wei",
+		Help:      "Distribution of bid values received from a relay, in wei.",
+		Buckets:   prometheus.ExponentialBuckets(1e15, 4, 10),
+	}, []string{"relay"})
+
+	// RelayCount is a gauge of the number of relays currently configured.
+	RelayCount = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "relays",
+		Help:      "Number of relays currently configured.",
+	})
+
+	// WithholdingCount counts suspected block withholding events, where a
+	// relay delivered the winning bid but failed to deliver the payload.
+	WithholdingCount = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "suspected_withholding_total",
+		Help:      "Number of times a relay is suspected of withholding a payload after winning the bid.",
+	})
+
+	// FilteredBidCount counts bids rejected by handleGetHeader, by reason.
+	FilteredBidCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "filtered_bids_total",
+		Help:      "Number of bids filtered out of selection, by reason.",
+	}, []string{"reason"})
+)
+
+// Reasons used with FilteredBidCount.
+const (
+	ReasonZeroValue          = "zero_value"
+	ReasonEmptyTxRoot        = "empty_tx_root"
+	ReasonSignatureMismatch  = "signature_mismatch"
+	ReasonPubkeyMismatch     = "pubkey_mismatch"
+	ReasonParentHashMismatch = "parent_hash_mismatch"
+)
+
+// Stages used with RelayRequestCount, RelayErrorCount and RelayResponseTime.
+const (
+	StageStatus            = "status"
+	StageRegisterValidator = "register_validator"
+	StageGetHeader         = "get_header"
+	StageGetPayload        = "get_payload"
+)
+
+// Handler returns the HTTP handler serving the /metrics endpoint.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// Timer measures the elapsed time of a relay request and records it against
+// RelayResponseTime when ObserveSuccess or ObserveError is called.
+type Timer struct {
+	relay, stage string
+	start        time.Time
+}
+
+// NewTimer starts a timer for a relay request. RelayRequestCount is
+// incremented immediately; call ObserveSuccess or ObserveError when the
+// request completes.
+func NewTimer(relay, stage string) *Timer {
+	RelayRequestCount.WithLabelValues(relay, stage).Inc()
+	return &Timer{relay: relay, stage: stage, start: time.Now()}
+}
+
+// ObserveError records the elapsed time and marks the request as failed.
+func (t *Timer) ObserveError() {
+	RelayResponseTime.WithLabelValues(t.relay, t.stage).Observe(time.Since(t.start).Seconds())
+	RelayErrorCount.WithLabelValues(t.relay, t.stage).Inc()
+}
+
+// ObserveSuccess records the elapsed time of a successful request.
+func (t *Timer) ObserveSuccess() {
+	RelayResponseTime.WithLabelValues(t.relay, t.stage).Observe(time.Since(t.start).Seconds())
+}
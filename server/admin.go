@@ -0,0 +1,228 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/flashbots/go-utils/httplogger"
+	"github.com/flashbots/mev-boost/config"
+	"github.com/flashbots/mev-boost/metrics"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	pathAdminRelays = "/admin/v1/relays"
+	pathAdminRelay  = "/admin/v1/relays/{pubkey}"
+	pathAdminConfig = "/admin/v1/config"
+	pathMetrics     = "/metrics"
+)
+
+var (
+	errRelayAlreadyExists = errors.New("relay with this pubkey is already configured")
+	errRelayNotFound      = errors.New("relay with this pubkey is not configured")
+)
+
+// adminAddRelayRequest is the request body for POST /admin/v1/relays
+type adminAddRelayRequest struct {
+	URL string `json:"url"`
+
+	// SkipStatusCheck disables the /eth/v1/builder/status probe mev-boost otherwise
+	// performs before accepting the new relay.
+	SkipStatusCheck bool `json:"skip_status_check"`
+}
+
+type adminRelayEntry struct {
+	URL    string `json:"url"`
+	Pubkey string `json:"pubkey"`
+}
+
+// getAdminRouter returns the router for the admin API. It is served on a
+// separate listen address from the main API so that it isn't reachable by
+// the consensus client.
+func (m *BoostService) getAdminRouter() http.Handler {
+	r := mux.NewRouter()
+	r.HandleFunc(pathAdminRelays, m.handleAdminListRelays).Methods(http.MethodGet)
+	r.HandleFunc(pathAdminRelays, m.handleAdminAddRelay).Methods(http.MethodPost)
+	r.HandleFunc(pathAdminRelay, m.handleAdminRemoveRelay).Methods(http.MethodDelete)
+	r.HandleFunc(pathAdminConfig, m.handleAdminUpdateConfig).Methods(http.MethodPatch)
+	r.Handle(pathMetrics, metrics.Handler()).Methods(http.MethodGet)
+
+	loggedRouter := httplogger.LoggingMiddlewareLogrus(m.log.WithField("module", "admin"), r)
+	return loggedRouter
+}
+
+// StartAdminServer starts the admin HTTP server for this boost service instance.
+// It is optional: if AdminListenAddr wasn't set, runtime relay management is
+// disabled and operators must restart mev-boost to change relays.
+func (m *BoostService) StartAdminServer() error {
+	if m.adminListenAddr == "" {
+		return nil
+	}
+
+	if m.adminSrv != nil {
+		return errServerAlreadyRunning
+	}
+
+	m.adminSrv = &http.Server{
+		Addr:    m.adminListenAddr,
+		Handler: m.getAdminRouter(),
+
+		ReadTimeout:       time.Duration(config.ServerReadTimeoutMs) * time.Millisecond,
+		ReadHeaderTimeout: time.Duration(config.ServerReadHeaderTimeoutMs) * time.Millisecond,
+		WriteTimeout:      time.Duration(config.ServerWriteTimeoutMs) * time.Millisecond,
+		IdleTimeout:       time.Duration(config.ServerIdleTimeoutMs) * time.Millisecond,
+
+		MaxHeaderBytes: config.ServerMaxHeaderBytes,
+	}
+
+	err := m.adminSrv.ListenAndServe()
+	if err == http.ErrServerClosed {
+		return nil
+	}
+	return err
+}
+
+func (m *BoostService) handleAdminListRelays(w http.ResponseWriter, req *http.Request) {
+	relays := m.getRelays()
+	resp := make([]adminRelayEntry, len(relays))
+	for i, relay := range relays {
+		resp[i] = adminRelayEntry{URL: relay.String(), Pubkey: relay.PublicKey.String()}
+	}
+	m.respondOK(w, resp)
+}
+
+func (m *BoostService) handleAdminAddRelay(w http.ResponseWriter, req *http.Request) {
+	log := m.log.WithField("method", "adminAddRelay")
+
+	payload := new(adminAddRelayRequest)
+	if err := DecodeJSON(req.Body, payload); err != nil {
+		m.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	relay, err := NewRelayEntry(payload.URL)
+	if err != nil {
+		m.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	log = log.WithField("relay", relay.String())
+
+	m.relaysLock.Lock()
+	for _, existing := range m.relays {
+		if existing.PublicKey == relay.PublicKey {
+			m.relaysLock.Unlock()
+			m.respondError(w, http.StatusConflict, errRelayAlreadyExists.Error())
+			return
+		}
+	}
+	m.relaysLock.Unlock()
+
+	if !payload.SkipStatusCheck {
+		url := relay.GetURI(pathStatus)
+		if _, err := SendHTTPRequest(req.Context(), m.httpClient, http.MethodGet, url, "", nil, nil); err != nil {
+			log.WithError(err).Warn("new relay failed status probe")
+			m.respondError(w, http.StatusBadGateway, fmt.Sprintf("relay status check failed: %s", err.Error()))
+			return
+		}
+	}
+
+	m.relaysLock.Lock()
+	defer m.relaysLock.Unlock()
+
+	// Re-check for a duplicate under the lock: another add for the same
+	// pubkey may have completed its own probe and inserted between our
+	// earlier check and now.
+	for _, existing := range m.relays {
+		if existing.PublicKey == relay.PublicKey {
+			m.respondError(w, http.StatusConflict, errRelayAlreadyExists.Error())
+			return
+		}
+	}
+
+	newRelays := append(append([]RelayEntry{}, m.relays...), relay)
+	m.setRelaysLocked(newRelays)
+
+	log.Info("relay added")
+	m.respondOK(w, adminRelayEntry{URL: relay.String(), Pubkey: relay.PublicKey.String()})
+}
+
+// adminUpdateConfigRequest is the request body for PATCH /admin/v1/config.
+// Values are decimal-encoded big.Int strings to avoid float precision loss
+// on wei-denominated amounts. Omitted fields are left unchanged. RelayWeights
+// are basis points (see BoostServiceOpts.RelayWeights).
+type adminUpdateConfigRequest struct {
+	MinBidWei    *string           `json:"min_bid_wei,omitempty"`
+	RelayWeights map[string]string `json:"relay_weights,omitempty"`
+}
+
+func (m *BoostService) handleAdminUpdateConfig(w http.ResponseWriter, req *http.Request) {
+	log := m.log.WithField("method", "adminUpdateConfig")
+
+	payload := new(adminUpdateConfigRequest)
+	if err := DecodeJSON(req.Body, payload); err != nil {
+		m.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Parse every field before applying any of them: map iteration order is
+	// randomized, so applying setters as we go would leave the service in a
+	// partial, non-reproducible state whenever a later field in the same
+	// request fails to parse.
+	var minBidWei *big.Int
+	if payload.MinBidWei != nil {
+		var ok bool
+		minBidWei, ok = new(big.Int).SetString(*payload.MinBidWei, 10)
+		if !ok {
+			m.respondError(w, http.StatusBadRequest, fmt.Sprintf("invalid min_bid_wei: %s", *payload.MinBidWei))
+			return
+		}
+	}
+
+	relayWeights := make(map[string]*big.Int, len(payload.RelayWeights))
+	for pubkey, weightStr := range payload.RelayWeights {
+		weight, ok := new(big.Int).SetString(weightStr, 10)
+		if !ok {
+			m.respondError(w, http.StatusBadRequest, fmt.Sprintf("invalid weight for relay %s: %s", pubkey, weightStr))
+			return
+		}
+		relayWeights[pubkey] = weight
+	}
+
+	if payload.MinBidWei != nil {
+		m.setMinBidWei(minBidWei)
+		log.WithField("minBidWei", minBidWei.String()).Info("updated minimum bid threshold")
+	}
+
+	for pubkey, weight := range relayWeights {
+		m.setRelayWeight(pubkey, weight)
+		log.WithFields(logrus.Fields{"pubkey": pubkey, "weight": weight.String()}).Info("updated relay weight")
+	}
+
+	m.respondOK(w, nilResponse)
+}
+
+func (m *BoostService) handleAdminRemoveRelay(w http.ResponseWriter, req *http.Request) {
+	pubkey := mux.Vars(req)["pubkey"]
+	log := m.log.WithFields(logrus.Fields{"method": "adminRemoveRelay", "pubkey": pubkey})
+
+	m.relaysLock.Lock()
+	defer m.relaysLock.Unlock()
+
+	for i, relay := range m.relays {
+		if relay.PublicKey.String() == pubkey {
+			newRelays := make([]RelayEntry, 0, len(m.relays)-1)
+			newRelays = append(newRelays, m.relays[:i]...)
+			newRelays = append(newRelays, m.relays[i+1:]...)
+			m.setRelaysLocked(newRelays)
+			log.Info("relay removed")
+			m.respondOK(w, nilResponse)
+			return
+		}
+	}
+
+	m.respondError(w, http.StatusNotFound, errRelayNotFound.Error())
+}
@@ -0,0 +1,176 @@
+package server
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/flashbots/go-boost-utils/types"
+)
+
+const bidHubRetention = 3 * time.Minute
+
+// bidEvent describes a single relay's contribution to a getHeader call, for
+// consumption by /eth/v1/builder/bids/stream subscribers.
+type bidEvent struct {
+	Slot           uint64    `json:"slot"`
+	Relay          string    `json:"relay,omitempty"`
+	BlockHash      string    `json:"block_hash,omitempty"`
+	Value          string    `json:"value,omitempty"`
+	GasUsed        uint64    `json:"gas_used,omitempty"`
+	TxRoot         string    `json:"tx_root,omitempty"`
+	SignatureValid bool      `json:"signature_valid"`
+	FilterReason   string    `json:"filter_reason,omitempty"`
+	EventType      string    `json:"event_type"` // "bid" or "best_bid"
+	Time           time.Time `json:"time"`
+}
+
+type slotBids struct {
+	relaysByBlockHash map[string][]string
+	lastSeen          time.Time
+
+	// bestEffectiveValue/bestResponse/bestBlockHash track the current winner
+	// for the slot, so handleGetHeader's HTTP response path reads its answer
+	// from the hub instead of keeping a second, parallel winner-selection
+	// store local to the handler.
+	bestEffectiveValue *big.Int
+	bestResponse       *types.GetHeaderResponse
+	bestBlockHash      string
+}
+
+// bidHub fans getHeader results out to SSE subscribers and keeps a
+// slot-keyed map of which relays delivered each bid, replacing the bespoke
+// withholding map previously kept on BoostService. Slots are evicted by age
+// in cleanup, not by count.
+type bidHub struct {
+	mu          sync.Mutex
+	subscribers map[chan bidEvent]struct{}
+	slots       map[uint64]*slotBids
+}
+
+func newBidHub() *bidHub {
+	return &bidHub{
+		subscribers: make(map[chan bidEvent]struct{}),
+		slots:       make(map[uint64]*slotBids),
+	}
+}
+
+// subscribe registers a new SSE client. The caller must call unsubscribe once
+// done reading.
+func (h *bidHub) subscribe() chan bidEvent {
+	ch := make(chan bidEvent, 64)
+	h.mu.Lock()
+	h.subscribers[ch] = struct{}{}
+	h.mu.Unlock()
+	return ch
+}
+
+func (h *bidHub) unsubscribe(ch chan bidEvent) {
+	h.mu.Lock()
+	delete(h.subscribers, ch)
+	h.mu.Unlock()
+}
+
+func (h *bidHub) broadcast(e bidEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.subscribers {
+		select {
+		case ch <- e:
+		default:
+			// Subscriber isn't keeping up; drop the event rather than block the relay fan-out.
+		}
+	}
+}
+
+// publishBid records an observed bid (accepted or filtered) for a slot and
+// broadcasts it to subscribers.
+func (h *bidHub) publishBid(e bidEvent) {
+	e.EventType = "bid"
+	e.Time = time.Now()
+
+	h.mu.Lock()
+	sb, ok := h.slots[e.Slot]
+	if !ok {
+		sb = &slotBids{relaysByBlockHash: make(map[string][]string)}
+		h.slots[e.Slot] = sb
+	}
+	sb.lastSeen = time.Now()
+	if e.FilterReason == "" && e.BlockHash != "" {
+		sb.relaysByBlockHash[e.BlockHash] = append(sb.relaysByBlockHash[e.BlockHash], e.Relay)
+	}
+	h.mu.Unlock()
+
+	h.broadcast(e)
+}
+
+// publishBestBid broadcasts the winning bid for a slot once handleGetHeader
+// has finished fanning out to every relay.
+func (h *bidHub) publishBestBid(e bidEvent) {
+	e.EventType = "best_bid"
+	e.Time = time.Now()
+	h.broadcast(e)
+}
+
+// recordCandidate considers a relay's accepted bid as a candidate winner for
+// the slot, replacing the current best if effectiveValue is a strict
+// improvement (see isBetterBid). It returns whether this candidate became
+// the new best, so callers can log accordingly.
+func (h *bidHub) recordCandidate(slot uint64, effectiveValue *big.Int, blockHash string, response *types.GetHeaderResponse) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	sb, ok := h.slots[slot]
+	if !ok {
+		sb = &slotBids{relaysByBlockHash: make(map[string][]string)}
+		h.slots[slot] = sb
+	}
+
+	if !isBetterBid(effectiveValue, sb.bestEffectiveValue, sb.bestResponse != nil) {
+		return false
+	}
+
+	sb.bestEffectiveValue = effectiveValue
+	sb.bestResponse = response
+	sb.bestBlockHash = blockHash
+	return true
+}
+
+// winningBid returns the best candidate bid recorded for the slot via
+// recordCandidate, or (nil, "") if no relay's bid survived filtering.
+func (h *bidHub) winningBid(slot uint64) (*types.GetHeaderResponse, string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	sb, ok := h.slots[slot]
+	if !ok || sb.bestResponse == nil {
+		return nil, ""
+	}
+	return sb.bestResponse, sb.bestBlockHash
+}
+
+// relaysForBid returns which relays delivered the bid for (slot, blockHash),
+// used to attribute suspected withholding in handleGetPayload.
+func (h *bidHub) relaysForBid(slot uint64, blockHash string) []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	sb, ok := h.slots[slot]
+	if !ok {
+		return nil
+	}
+	return sb.relaysByBlockHash[blockHash]
+}
+
+// cleanup periodically evicts slots older than bidHubRetention.
+func (h *bidHub) cleanup() {
+	for {
+		time.Sleep(1 * time.Minute)
+		h.mu.Lock()
+		for slot, sb := range h.slots {
+			if time.Since(sb.lastSeen) > bidHubRetention {
+				delete(h.slots, slot)
+			}
+		}
+		h.mu.Unlock()
+	}
+}
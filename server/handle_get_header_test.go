@@ -0,0 +1,213 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flashbots/go-boost-utils/bls"
+	"github.com/flashbots/go-boost-utils/types"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+)
+
+const testGenesisForkVersionHex = "0x00000000"
+
+// testDomain computes the same builder-signing domain NewBoostService derives
+// from testGenesisForkVersionHex, so stub relay responses built here verify
+// against it.
+func testDomain(t *testing.T) types.Domain {
+	t.Helper()
+	domain, err := ComputeDomain(types.DomainTypeAppBuilder, testGenesisForkVersionHex, types.Root{}.String())
+	if err != nil {
+		t.Fatalf("failed to compute test signing domain: %v", err)
+	}
+	return domain
+}
+
+// newGetHeaderTestService builds a BoostService through the production
+// construction path (NewBoostService) wired to the given relays, so the
+// handler under test exercises the real domain computation and weighting,
+// not a hand-assembled struct.
+func newGetHeaderTestService(t *testing.T, relays []RelayEntry, minBidWei *big.Int) *BoostService {
+	t.Helper()
+	svc, err := NewBoostService(BoostServiceOpts{
+		Log:                   logrus.NewEntry(logrus.New()),
+		Relays:                relays,
+		GenesisForkVersionHex: testGenesisForkVersionHex,
+		RelayRequestTimeout:   time.Second,
+		MinBidWei:             minBidWei,
+	})
+	if err != nil {
+		t.Fatalf("failed to build test boost service: %v", err)
+	}
+	return svc
+}
+
+// relayEntryForServer builds a RelayEntry pointing at a stub relay's
+// httptest.Server, with pubkeyHex as its configured identity.
+func relayEntryForServer(t *testing.T, srv *httptest.Server, pubkeyHex string) RelayEntry {
+	t.Helper()
+	host := strings.TrimPrefix(srv.URL, "http://")
+	relay, err := NewRelayEntry(fmt.Sprintf("http://%s@%s", pubkeyHex, host))
+	if err != nil {
+		t.Fatalf("failed to build test relay entry: %v", err)
+	}
+	return relay
+}
+
+// fixedTestPubkeyHex returns a syntactically valid (but not necessarily
+// cryptographically meaningful) 48-byte BLS pubkey hex string derived from n,
+// for tests that don't need a signature to verify.
+func fixedTestPubkeyHex(n int) string {
+	return fmt.Sprintf("0x%096x", n)
+}
+
+// doGetHeader drives handleGetHeader directly with the given path
+// parameters, bypassing the router (matching this package's existing direct-
+// handler test style).
+func doGetHeader(m *BoostService, slot, parentHash, pubkey string) *httptest.ResponseRecorder {
+	path := fmt.Sprintf("/eth/v1/builder/header/%s/%s/%s", slot, parentHash, pubkey)
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req = mux.SetURLVars(req, map[string]string{"slot": slot, "parent_hash": parentHash, "pubkey": pubkey})
+	w := httptest.NewRecorder()
+	m.handleGetHeader(w, req)
+	return w
+}
+
+// TestHandleGetHeaderAllRelaysFilteredReturns204 drives handleGetHeader
+// end-to-end against stub relay servers that each return a bid whose pubkey
+// doesn't match their configured relay identity -- filtered by the
+// pubkey-mismatch check before signature verification. With every relay's
+// bid filtered out, the handler must respond 204, not 502.
+func TestHandleGetHeaderAllRelaysFilteredReturns204(t *testing.T) {
+	const slot = "1"
+	parentHash := "0x" + strings.Repeat("aa", 32)
+	proposer := "0x" + strings.Repeat("bb", 48)
+
+	var mismatchPubkey types.PublicKey
+	if err := mismatchPubkey.UnmarshalText([]byte(fixedTestPubkeyHex(9999))); err != nil {
+		t.Fatalf("failed to build mismatched pubkey: %v", err)
+	}
+	var blockHash types.Hash
+	if err := blockHash.UnmarshalText([]byte("0x" + strings.Repeat("cc", 32))); err != nil {
+		t.Fatalf("failed to build block hash: %v", err)
+	}
+	var parentHashSSZ types.Hash
+	if err := parentHashSSZ.UnmarshalText([]byte(parentHash)); err != nil {
+		t.Fatalf("failed to build parent hash: %v", err)
+	}
+	var txRoot types.Root
+	if err := txRoot.UnmarshalText([]byte("0x" + strings.Repeat("dd", 32))); err != nil {
+		t.Fatalf("failed to build transactions root: %v", err)
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		resp := types.GetHeaderResponse{
+			Version: "bellatrix",
+			Data: &types.SignedBuilderBid{
+				Message: &types.BuilderBid{
+					Header: &types.ExecutionPayloadHeader{
+						ParentHash:       parentHashSSZ,
+						BlockHash:        blockHash,
+						TransactionsRoot: txRoot,
+						GasUsed:          21000,
+						BlockNumber:      1,
+					},
+					Value:  types.IntToU256(1_000_000),
+					Pubkey: mismatchPubkey,
+				},
+			},
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}
+
+	srv1 := httptest.NewServer(http.HandlerFunc(handler))
+	defer srv1.Close()
+	srv2 := httptest.NewServer(http.HandlerFunc(handler))
+	defer srv2.Close()
+
+	relays := []RelayEntry{
+		relayEntryForServer(t, srv1, fixedTestPubkeyHex(1)),
+		relayEntryForServer(t, srv2, fixedTestPubkeyHex(2)),
+	}
+	m := newGetHeaderTestService(t, relays, nil)
+
+	w := doGetHeader(m, slot, parentHash, proposer)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("expected 204 when every relay's bid is filtered out, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+// TestHandleGetHeaderThresholdEqualBidAccepted drives handleGetHeader
+// end-to-end against a stub relay returning a validly signed bid whose value
+// exactly equals the configured MinBidWei, asserting the real handler treats
+// the threshold as inclusive and returns the bid rather than 204.
+func TestHandleGetHeaderThresholdEqualBidAccepted(t *testing.T) {
+	const slot = "1"
+	parentHash := "0x" + strings.Repeat("aa", 32)
+	proposer := "0x" + strings.Repeat("bb", 48)
+
+	sk, pk, err := bls.GenerateNewKeypair()
+	if err != nil {
+		t.Fatalf("failed to generate test BLS keypair: %v", err)
+	}
+	relayPubkey := types.BlsPublicKeyToPublicKey(pk)
+
+	var blockHash types.Hash
+	if err := blockHash.UnmarshalText([]byte("0x" + strings.Repeat("cc", 32))); err != nil {
+		t.Fatalf("failed to build block hash: %v", err)
+	}
+	var parentHashSSZ types.Hash
+	if err := parentHashSSZ.UnmarshalText([]byte(parentHash)); err != nil {
+		t.Fatalf("failed to build parent hash: %v", err)
+	}
+	var txRoot types.Root
+	if err := txRoot.UnmarshalText([]byte("0x" + strings.Repeat("dd", 32))); err != nil {
+		t.Fatalf("failed to build transactions root: %v", err)
+	}
+
+	minBidWei := big.NewInt(1_000_000)
+
+	msg := &types.BuilderBid{
+		Header: &types.ExecutionPayloadHeader{
+			ParentHash:       parentHashSSZ,
+			BlockHash:        blockHash,
+			TransactionsRoot: txRoot,
+			GasUsed:          21000,
+			BlockNumber:      1,
+		},
+		Value:  types.IntToU256(minBidWei.Uint64()),
+		Pubkey: relayPubkey,
+	}
+
+	signature, err := types.SignMessage(msg, testDomain(t), sk)
+	if err != nil {
+		t.Fatalf("failed to sign test bid: %v", err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := types.GetHeaderResponse{
+			Version: "bellatrix",
+			Data: &types.SignedBuilderBid{
+				Message:   msg,
+				Signature: signature,
+			},
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+
+	relay := relayEntryForServer(t, srv, relayPubkey.String())
+	m := newGetHeaderTestService(t, []RelayEntry{relay}, minBidWei)
+
+	w := doGetHeader(m, slot, parentHash, proposer)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected a bid exactly at minBidWei to be accepted, not filtered, got %d: %s", w.Code, w.Body.String())
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"net/http"
 	"strconv"
 	"strings"
@@ -15,6 +16,7 @@ import (
 	"github.com/flashbots/go-boost-utils/types"
 	"github.com/flashbots/go-utils/httplogger"
 	"github.com/flashbots/mev-boost/config"
+	"github.com/flashbots/mev-boost/metrics"
 	"github.com/gorilla/mux"
 	"github.com/sirupsen/logrus"
 )
@@ -31,6 +33,8 @@ var (
 var nilHash = types.Hash{}
 var nilResponse = struct{}{}
 
+const pathBidsStream = "/eth/v1/builder/bids/stream"
+
 type httpErrorResp struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
@@ -40,25 +44,49 @@ type httpErrorResp struct {
 type BoostServiceOpts struct {
 	Log                   *logrus.Entry
 	ListenAddr            string
+	AdminListenAddr       string
 	Relays                []RelayEntry
 	GenesisForkVersionHex string
 	RelayRequestTimeout   time.Duration
 	RelayCheck            bool
+
+	// MinBidWei, if set, causes handleGetHeader to return 204 No Content
+	// instead of a bid whose value is below this threshold.
+	MinBidWei *big.Int
+
+	// RelayWeights biases bid selection towards specific relays without
+	// altering the value returned to the proposer. Keyed by relay pubkey
+	// (RelayEntry.PublicKey.String()). Values are basis points (1/10000ths),
+	// so 10000 is neutral, 5000 halves a relay's effective value and 11000
+	// biases it up by 10%. Relays missing from this map default to
+	// relayWeightBasisPointsNeutral.
+	RelayWeights map[string]*big.Int
 }
 
+// relayWeightBasisPointsNeutral is the RelayWeights value that leaves a
+// relay's bid value unchanged when ranking bids.
+const relayWeightBasisPointsNeutral = 10000
+
 // BoostService - the mev-boost service
 type BoostService struct {
-	listenAddr string
+	listenAddr      string
+	adminListenAddr string
+	log             *logrus.Entry
+	srv             *http.Server
+	adminSrv        *http.Server
+	relayCheck      bool
+
+	relaysLock sync.RWMutex
 	relays     []RelayEntry
-	log        *logrus.Entry
-	srv        *http.Server
-	relayCheck bool
+
+	configLock   sync.RWMutex
+	minBidWei    *big.Int
+	relayWeights map[string]*big.Int
 
 	builderSigningDomain types.Domain
 	httpClient           http.Client
 
-	bidsLock sync.Mutex
-	bids     map[bidRespKey]bidResp // keeping track of bids, to log the originating relay on withholding
+	bidHub *bidHub // slot-keyed fan-in of relay bids, also feeds the /eth/v1/builder/bids/stream SSE endpoint
 }
 
 // NewBoostService created a new BoostService
@@ -72,12 +100,22 @@ func NewBoostService(opts BoostServiceOpts) (*BoostService, error) {
 		return nil, err
 	}
 
+	relayWeights := make(map[string]*big.Int, len(opts.RelayWeights))
+	for pubkey, weight := range opts.RelayWeights {
+		relayWeights[pubkey] = weight
+	}
+
+	metrics.RelayCount.Set(float64(len(opts.Relays)))
+
 	return &BoostService{
-		listenAddr: opts.ListenAddr,
-		relays:     opts.Relays,
-		log:        opts.Log.WithField("module", "service"),
-		relayCheck: opts.RelayCheck,
-		bids:       make(map[bidRespKey]bidResp),
+		listenAddr:      opts.ListenAddr,
+		adminListenAddr: opts.AdminListenAddr,
+		relays:          opts.Relays,
+		log:             opts.Log.WithField("module", "service"),
+		relayCheck:      opts.RelayCheck,
+		bidHub:          newBidHub(),
+		minBidWei:       opts.MinBidWei,
+		relayWeights:    relayWeights,
 
 		builderSigningDomain: builderSigningDomain,
 		httpClient: http.Client{
@@ -89,6 +127,59 @@ func NewBoostService(opts BoostServiceOpts) (*BoostService, error) {
 	}, nil
 }
 
+// getRelays returns a snapshot of the currently configured relays. The
+// returned slice is a copy, so callers can range over it and dispatch
+// fan-out goroutines without racing a concurrent admin add/remove.
+func (m *BoostService) getRelays() []RelayEntry {
+	m.relaysLock.RLock()
+	defer m.relaysLock.RUnlock()
+	relays := make([]RelayEntry, len(m.relays))
+	copy(relays, m.relays)
+	return relays
+}
+
+// setRelaysLocked replaces the configured relay set. Callers must already
+// hold relaysLock.
+func (m *BoostService) setRelaysLocked(relays []RelayEntry) {
+	m.relays = relays
+	metrics.RelayCount.Set(float64(len(relays)))
+}
+
+// getMinBidWei returns the currently configured minimum bid threshold, or nil
+// if none is set.
+func (m *BoostService) getMinBidWei() *big.Int {
+	m.configLock.RLock()
+	defer m.configLock.RUnlock()
+	return m.minBidWei
+}
+
+// setMinBidWei updates the minimum bid threshold used by handleGetHeader.
+func (m *BoostService) setMinBidWei(minBidWei *big.Int) {
+	m.configLock.Lock()
+	defer m.configLock.Unlock()
+	m.minBidWei = minBidWei
+}
+
+// getRelayWeight returns the configured weight, in basis points, for the
+// given relay pubkey, defaulting to relayWeightBasisPointsNeutral if the
+// relay has no explicit weight.
+func (m *BoostService) getRelayWeight(pubkey string) *big.Int {
+	m.configLock.RLock()
+	defer m.configLock.RUnlock()
+	if weight, ok := m.relayWeights[pubkey]; ok {
+		return weight
+	}
+	return big.NewInt(relayWeightBasisPointsNeutral)
+}
+
+// setRelayWeight updates the basis-points bias applied to a relay's bid
+// value when selecting the winning bid in handleGetHeader.
+func (m *BoostService) setRelayWeight(pubkey string, weight *big.Int) {
+	m.configLock.Lock()
+	defer m.configLock.Unlock()
+	m.relayWeights[pubkey] = weight
+}
+
 func (m *BoostService) respondError(w http.ResponseWriter, code int, message string) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(code)
@@ -116,6 +207,7 @@ func (m *BoostService) getRouter() http.Handler {
 	r.HandleFunc(pathRegisterValidator, m.handleRegisterValidator).Methods(http.MethodPost)
 	r.HandleFunc(pathGetHeader, m.handleGetHeader).Methods(http.MethodGet)
 	r.HandleFunc(pathGetPayload, m.handleGetPayload).Methods(http.MethodPost)
+	r.HandleFunc(pathBidsStream, m.handleBidsStream).Methods(http.MethodGet)
 
 	r.Use(mux.CORSMethodMiddleware(r))
 	loggedRouter := httplogger.LoggingMiddlewareLogrus(m.log, r)
@@ -128,7 +220,7 @@ func (m *BoostService) StartHTTPServer() error {
 		return errServerAlreadyRunning
 	}
 
-	go m.startBidCacheCleanupTask()
+	go m.bidHub.cleanup()
 
 	m.srv = &http.Server{
 		Addr:    m.listenAddr,
@@ -149,19 +241,6 @@ func (m *BoostService) StartHTTPServer() error {
 	return err
 }
 
-func (m *BoostService) startBidCacheCleanupTask() {
-	for {
-		time.Sleep(1 * time.Minute)
-		m.bidsLock.Lock()
-		for k, bidResp := range m.bids {
-			if time.Since(bidResp.t) > 3*time.Minute {
-				delete(m.bids, k)
-			}
-		}
-		m.bidsLock.Unlock()
-	}
-}
-
 func (m *BoostService) handleRoot(w http.ResponseWriter, req *http.Request) {
 	m.respondOK(w, nilResponse)
 }
@@ -181,7 +260,8 @@ func (m *BoostService) handleStatus(w http.ResponseWriter, req *http.Request) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	for _, r := range m.relays {
+	relays := m.getRelays()
+	for _, r := range relays {
 		wg.Add(1)
 
 		go func(relay RelayEntry) {
@@ -190,11 +270,19 @@ func (m *BoostService) handleStatus(w http.ResponseWriter, req *http.Request) {
 			log := m.log.WithField("url", url)
 			log.Debug("Checking relay status")
 
+			timer := metrics.NewTimer(relay.String(), metrics.StageStatus)
 			_, err := SendHTTPRequest(ctx, m.httpClient, http.MethodGet, url, ua, nil, nil)
-			if err != nil && ctx.Err() != context.Canceled {
+			if err != nil {
+				if ctx.Err() == context.Canceled {
+					// Aborted because another relay already won the race, not
+					// a real failure of this relay -- don't record it either way.
+					return
+				}
+				timer.ObserveError()
 				log.WithError(err).Error("failed to retrieve relay status")
 				return
 			}
+			timer.ObserveSuccess()
 
 			// Success: increase counter and cancel all pending requests to other relays
 			atomic.AddUint32(&numSuccessRequestsToRelay, 1)
@@ -229,23 +317,27 @@ func (m *BoostService) handleRegisterValidator(w http.ResponseWriter, req *http.
 		"ua":               ua,
 	})
 
-	relayRespCh := make(chan error, len(m.relays))
+	relays := m.getRelays()
+	relayRespCh := make(chan error, len(relays))
 
-	for _, relay := range m.relays {
+	for _, relay := range relays {
 		go func(relay RelayEntry) {
 			url := relay.GetURI(pathRegisterValidator)
 			log := log.WithField("url", url)
 
+			timer := metrics.NewTimer(relay.String(), metrics.StageRegisterValidator)
 			_, err := SendHTTPRequest(context.Background(), m.httpClient, http.MethodPost, url, ua, payload, nil)
 			relayRespCh <- err
 			if err != nil {
+				timer.ObserveError()
 				log.WithError(err).Warn("error calling registerValidator on relay")
 				return
 			}
+			timer.ObserveSuccess()
 		}(relay)
 	}
 
-	for i := 0; i < len(m.relays); i++ {
+	for i := 0; i < len(relays); i++ {
 		respErr := <-relayRespCh
 		if respErr == nil {
 			m.respondOK(w, nilResponse)
@@ -256,6 +348,24 @@ func (m *BoostService) handleRegisterValidator(w http.ResponseWriter, req *http.
 	m.respondError(w, http.StatusBadGateway, errNoSuccessfulRelayResponse.Error())
 }
 
+// isBetterBid reports whether effectiveValue should replace the current best
+// bid. Ties are resolved in favor of the bid already held, so the first
+// relay to deliver a given effective value wins over later relays that match
+// but don't exceed it.
+func isBetterBid(effectiveValue, bestEffectiveValue *big.Int, haveBid bool) bool {
+	if !haveBid {
+		return true
+	}
+	return effectiveValue.Cmp(bestEffectiveValue) > 0
+}
+
+// isBelowMinBid reports whether value falls below the configured minimum bid
+// threshold. A nil minBidWei means no threshold is configured. A value equal
+// to the threshold is accepted, not filtered.
+func isBelowMinBid(value, minBidWei *big.Int) bool {
+	return minBidWei != nil && value.Cmp(minBidWei) < 0
+}
+
 // handleGetHeader requests bids from the relays
 func (m *BoostService) handleGetHeader(w http.ResponseWriter, req *http.Request) {
 	vars := mux.Vars(req)
@@ -286,27 +396,27 @@ func (m *BoostService) handleGetHeader(w http.ResponseWriter, req *http.Request)
 		return
 	}
 
-	var mu sync.Mutex
-	relays := make(map[string][]string) // relays per blockHash
-	result := bidResp{}
-
 	ua := UserAgent(req.Header.Get("User-Agent"))
 
 	// Call the relays
 	var wg sync.WaitGroup
-	for _, relay := range m.relays {
+	relays := m.getRelays()
+	for _, relay := range relays {
 		wg.Add(1)
 		go func(relay RelayEntry) {
 			defer wg.Done()
 			path := fmt.Sprintf("/eth/v1/builder/header/%s/%s/%s", slot, parentHashHex, pubkey)
 			url := relay.GetURI(path)
 			log := log.WithField("url", url)
+			timer := metrics.NewTimer(relay.String(), metrics.StageGetHeader)
 			responsePayload := new(types.GetHeaderResponse)
 			code, err := SendHTTPRequest(context.Background(), m.httpClient, http.MethodGet, url, ua, nil, responsePayload)
 			if err != nil {
+				timer.ObserveError()
 				log.WithError(err).Warn("error making request to relay")
 				return
 			}
+			timer.ObserveSuccess()
 
 			if code == http.StatusNoContent {
 				log.Debug("no-content response")
@@ -326,7 +436,13 @@ func (m *BoostService) handleGetHeader(w http.ResponseWriter, req *http.Request)
 				"value":       responsePayload.Data.Message.Value.String(),
 			})
 
+			gasUsed := responsePayload.Data.Message.Header.GasUsed
+			txRoot := responsePayload.Data.Message.Header.TransactionsRoot.String()
+			value := responsePayload.Data.Message.Value.String()
+
 			if relay.PublicKey != responsePayload.Data.Message.Pubkey {
+				metrics.FilteredBidCount.WithLabelValues(metrics.ReasonPubkeyMismatch).Inc()
+				m.bidHub.publishBid(bidEvent{Slot: _slot, Relay: relay.String(), BlockHash: blockHash, Value: value, GasUsed: gasUsed, TxRoot: txRoot, FilterReason: metrics.ReasonPubkeyMismatch})
 				log.Errorf("bid pubkey mismatch. expected: %s - got: %s", relay.PublicKey.String(), responsePayload.Data.Message.Pubkey.String())
 				return
 			}
@@ -338,6 +454,8 @@ func (m *BoostService) handleGetHeader(w http.ResponseWriter, req *http.Request)
 				return
 			}
 			if !ok {
+				metrics.FilteredBidCount.WithLabelValues(metrics.ReasonSignatureMismatch).Inc()
+				m.bidHub.publishBid(bidEvent{Slot: _slot, Relay: relay.String(), BlockHash: blockHash, Value: value, GasUsed: gasUsed, TxRoot: txRoot, FilterReason: metrics.ReasonSignatureMismatch})
 				log.Error("failed to verify relay signature")
 				return
 			}
@@ -345,6 +463,8 @@ func (m *BoostService) handleGetHeader(w http.ResponseWriter, req *http.Request)
 			// Verify response coherence with proposer's input data
 			responseParentHash := responsePayload.Data.Message.Header.ParentHash.String()
 			if responseParentHash != parentHashHex {
+				metrics.FilteredBidCount.WithLabelValues(metrics.ReasonParentHashMismatch).Inc()
+				m.bidHub.publishBid(bidEvent{Slot: _slot, Relay: relay.String(), BlockHash: blockHash, Value: value, GasUsed: gasUsed, TxRoot: txRoot, SignatureValid: true, FilterReason: metrics.ReasonParentHashMismatch})
 				log.WithFields(logrus.Fields{
 					"originalParentHash": parentHashHex,
 					"responseParentHash": responseParentHash,
@@ -355,60 +475,77 @@ func (m *BoostService) handleGetHeader(w http.ResponseWriter, req *http.Request)
 			isZeroValue := responsePayload.Data.Message.Value.String() == "0"
 			isEmptyListTxRoot := responsePayload.Data.Message.Header.TransactionsRoot.String() == "0x7ffe241ea60187fdb0187bfa22de35d1f9bed7ab061d9401fd47e34a54fbede1"
 			if isZeroValue || isEmptyListTxRoot {
+				reason := metrics.ReasonZeroValue
+				if isEmptyListTxRoot {
+					reason = metrics.ReasonEmptyTxRoot
+				}
+				metrics.FilteredBidCount.WithLabelValues(reason).Inc()
+				m.bidHub.publishBid(bidEvent{Slot: _slot, Relay: relay.String(), BlockHash: blockHash, Value: value, GasUsed: gasUsed, TxRoot: txRoot, SignatureValid: true, FilterReason: reason})
 				log.Warn("ignoring bid with 0 value")
 				return
 			}
 
-			mu.Lock()
-			defer mu.Unlock()
-
-			// Remember which relays delivered which bids (multiple relays might deliver the top bid)
-			if _, ok := relays[blockHash]; !ok {
-				relays[blockHash] = []string{relay.String()}
-			} else {
-				relays[blockHash] = append(relays[blockHash], relay.String())
-			}
-
-			// Skip if value (fee) is not greater than the current highest value
-			if result.response.Data != nil && responsePayload.Data.Message.Value.Cmp(&result.response.Data.Message.Value) < 1 {
-				return
+			bidValueWei, _ := new(big.Float).SetInt(responsePayload.Data.Message.Value.BigInt()).Float64()
+			metrics.RelayBidValue.WithLabelValues(relay.String()).Observe(bidValueWei)
+
+			// Record and broadcast the accepted bid. This also keeps the
+			// slot's relay-attribution up to date for withholding detection.
+			m.bidHub.publishBid(bidEvent{Slot: _slot, Relay: relay.String(), BlockHash: blockHash, Value: value, GasUsed: gasUsed, TxRoot: txRoot, SignatureValid: true})
+
+			// Apply the relay's weight (basis points) to its raw bid value to
+			// rank it, without altering the value ultimately returned to the
+			// proposer.
+			weight := m.getRelayWeight(relay.PublicKey.String())
+			effectiveValue := new(big.Int).Mul(responsePayload.Data.Message.Value.BigInt(), weight)
+			effectiveValue.Div(effectiveValue, big.NewInt(relayWeightBasisPointsNeutral))
+
+			// Record this bid as a candidate winner in the hub. The hub holds
+			// the only copy of "who's winning" for the slot; the HTTP response
+			// path below reads its answer back from here instead of a second,
+			// handler-local store.
+			if m.bidHub.recordCandidate(_slot, effectiveValue, blockHash, responsePayload) {
+				log.Debug("received a good bid")
 			}
-
-			// Use this relay's response as mev-boost response because it's most profitable
-			log.Debug("received a good bid")
-			result.response = *responsePayload
-			result.blockHash = blockHash
-			result.t = time.Now()
 		}(relay)
 	}
 
 	// Wait for all requests to complete...
 	wg.Wait()
 
-	if result.blockHash == "" {
+	response, blockHash := m.bidHub.winningBid(_slot)
+	if response == nil {
 		log.Info("no bid received")
 		w.WriteHeader(http.StatusNoContent)
 		return
 	}
 
+	if minBidWei := m.getMinBidWei(); isBelowMinBid(response.Data.Message.Value.BigInt(), minBidWei) {
+		log.WithField("minBidWei", minBidWei.String()).Info("best bid below minimum bid threshold")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
 	// Log result
-	result.relays = relays[result.blockHash]
+	winningRelays := m.bidHub.relaysForBid(_slot, blockHash)
 	log.WithFields(logrus.Fields{
-		"blockHash":   result.blockHash,
-		"blockNumber": result.response.Data.Message.Header.BlockNumber,
-		"txRoot":      result.response.Data.Message.Header.TransactionsRoot.String(),
-		"value":       result.response.Data.Message.Value.String(),
-		"relays":      strings.Join(result.relays, ", "),
+		"blockHash":   blockHash,
+		"blockNumber": response.Data.Message.Header.BlockNumber,
+		"txRoot":      response.Data.Message.Header.TransactionsRoot.String(),
+		"value":       response.Data.Message.Value.String(),
+		"relays":      strings.Join(winningRelays, ", "),
 	}).Info("best bid")
 
-	// Remember the bid, for future logging in case of withholding
-	bidKey := bidRespKey{slot: _slot, blockHash: result.blockHash}
-	m.bidsLock.Lock()
-	m.bids[bidKey] = result
-	m.bidsLock.Unlock()
+	m.bidHub.publishBestBid(bidEvent{
+		Slot:           _slot,
+		BlockHash:      blockHash,
+		Value:          response.Data.Message.Value.String(),
+		GasUsed:        response.Data.Message.Header.GasUsed,
+		TxRoot:         response.Data.Message.Header.TransactionsRoot.String(),
+		SignatureValid: true,
+	})
 
 	// Return the bid
-	m.respondOK(w, result.response)
+	m.respondOK(w, *response)
 }
 
 func (m *BoostService) handleGetPayload(w http.ResponseWriter, req *http.Request) {
@@ -431,7 +568,7 @@ func (m *BoostService) handleGetPayload(w http.ResponseWriter, req *http.Request
 	requestCtx, requestCtxCancel := context.WithCancel(context.Background())
 	defer requestCtxCancel()
 
-	for _, relay := range m.relays {
+	for _, relay := range m.getRelays() {
 		wg.Add(1)
 		go func(relay RelayEntry) {
 			defer wg.Done()
@@ -439,13 +576,16 @@ func (m *BoostService) handleGetPayload(w http.ResponseWriter, req *http.Request
 			log := log.WithField("url", url)
 			log.Debug("calling getPayload")
 
+			timer := metrics.NewTimer(relay.String(), metrics.StageGetPayload)
 			responsePayload := new(types.GetPayloadResponse)
 			_, err := SendHTTPRequest(requestCtx, m.httpClient, http.MethodPost, url, ua, payload, responsePayload)
 
 			if err != nil {
+				timer.ObserveError()
 				log.WithError(err).Error("error making request to relay")
 				return
 			}
+			timer.ObserveSuccess()
 
 			if responsePayload.Data == nil || responsePayload.Data.BlockHash == nilHash {
 				log.Error("response with empty data!")
@@ -480,11 +620,10 @@ func (m *BoostService) handleGetPayload(w http.ResponseWriter, req *http.Request
 
 	// If no payload has been received from relay, log loudly about withholding!
 	if result.Data == nil || result.Data.BlockHash == nilHash {
-		bidKey := bidRespKey{slot: payload.Message.Slot, blockHash: payload.Message.Body.ExecutionPayloadHeader.BlockHash.String()}
-		m.bidsLock.Lock()
-		originalResp := m.bids[bidKey]
-		m.bidsLock.Unlock()
-		log.WithField("relays", strings.Join(originalResp.relays, ", ")).Errorf("no payload received from relay -- withholding or network error --")
+		blockHash := payload.Message.Body.ExecutionPayloadHeader.BlockHash.String()
+		relays := m.bidHub.relaysForBid(payload.Message.Slot, blockHash)
+		metrics.WithholdingCount.Inc()
+		log.WithField("relays", strings.Join(relays, ", ")).Errorf("no payload received from relay -- withholding or network error --")
 		m.respondError(w, http.StatusBadGateway, errNoSuccessfulRelayResponse.Error())
 		return
 	}
@@ -492,9 +631,53 @@ func (m *BoostService) handleGetPayload(w http.ResponseWriter, req *http.Request
 	m.respondOK(w, result)
 }
 
+// handleBidsStream streams one SSE event per relay bid collected inside
+// handleGetHeader, plus a final best_bid event once a slot's winner is
+// chosen. Subscribers connect once and receive events for all future slots.
+func (m *BoostService) handleBidsStream(w http.ResponseWriter, req *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		m.respondError(w, http.StatusInternalServerError, "streaming not supported")
+		return
+	}
+
+	// m.srv's WriteTimeout is sized for the short-lived request/response
+	// handlers and would otherwise cut this long-lived connection off after
+	// a fixed duration. Disable the per-connection write deadline for this
+	// route only.
+	if err := http.NewResponseController(w).SetWriteDeadline(time.Time{}); err != nil {
+		m.log.WithError(err).Warn("failed to disable write deadline for bids stream")
+	}
+
+	ch := m.bidHub.subscribe()
+	defer m.bidHub.unsubscribe(ch)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ctx := req.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-ch:
+			data, err := json.Marshal(event)
+			if err != nil {
+				m.log.WithError(err).Error("failed to marshal bid event")
+				continue
+			}
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.EventType, data)
+			flusher.Flush()
+		}
+	}
+}
+
 // CheckRelays sends a request to each one of the relays previously registered to get their status
 func (m *BoostService) CheckRelays() bool {
-	for _, relay := range m.relays {
+	for _, relay := range m.getRelays() {
 		m.log.WithField("relay", relay.String()).Info("Checking relay")
 
 		url := relay.GetURI(pathStatus)
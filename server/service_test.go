@@ -0,0 +1,116 @@
+package server
+
+import (
+	"math/big"
+	"testing"
+)
+
+// TestIsBetterBidTieBreak verifies that a bid whose effective value merely
+// ties the current best is not treated as an improvement, so the first
+// relay to deliver a given value keeps the slot instead of being displaced
+// by a later relay offering the same amount.
+func TestIsBetterBidTieBreak(t *testing.T) {
+	best := big.NewInt(100)
+	candidate := big.NewInt(100)
+
+	if isBetterBid(candidate, best, true) {
+		t.Fatal("expected a tied effective value not to replace the existing best bid")
+	}
+}
+
+func TestIsBetterBidNoPriorBid(t *testing.T) {
+	candidate := big.NewInt(1)
+
+	if !isBetterBid(candidate, big.NewInt(0), false) {
+		t.Fatal("expected the first bid received to always be accepted")
+	}
+}
+
+func TestIsBetterBidStrictlyGreater(t *testing.T) {
+	best := big.NewInt(100)
+	candidate := big.NewInt(101)
+
+	if !isBetterBid(candidate, best, true) {
+		t.Fatal("expected a strictly greater effective value to replace the existing best bid")
+	}
+}
+
+// TestIsBelowMinBidEqualToThreshold verifies the threshold is inclusive: a
+// bid exactly equal to MinBidWei is accepted, not filtered.
+func TestIsBelowMinBidEqualToThreshold(t *testing.T) {
+	minBidWei := big.NewInt(1000)
+	value := big.NewInt(1000)
+
+	if isBelowMinBid(value, minBidWei) {
+		t.Fatal("expected a bid equal to minBidWei not to be treated as below threshold")
+	}
+}
+
+func TestIsBelowMinBidBelowThreshold(t *testing.T) {
+	minBidWei := big.NewInt(1000)
+	value := big.NewInt(999)
+
+	if !isBelowMinBid(value, minBidWei) {
+		t.Fatal("expected a bid below minBidWei to be filtered")
+	}
+}
+
+func TestIsBelowMinBidNoThresholdConfigured(t *testing.T) {
+	if isBelowMinBid(big.NewInt(0), nil) {
+		t.Fatal("expected no threshold to accept any bid value, including zero")
+	}
+}
+
+// TestGetRelayWeightDefaultsNeutral verifies that a relay with no configured
+// weight ranks using its raw bid value, not a fractional or zeroed one.
+func TestGetRelayWeightDefaultsNeutral(t *testing.T) {
+	m := &BoostService{relayWeights: map[string]*big.Int{}}
+
+	weight := m.getRelayWeight("missing-pubkey")
+	if weight.Cmp(big.NewInt(relayWeightBasisPointsNeutral)) != 0 {
+		t.Fatalf("expected default weight %d, got %s", relayWeightBasisPointsNeutral, weight.String())
+	}
+}
+
+// TestRelayWeightBasisPointsSubIntegerBias verifies that a basis-points
+// weight below the neutral value of 10000 can express a sub-integer bias
+// (e.g. a 10% penalty), which a raw integer multiplier could never express.
+func TestRelayWeightBasisPointsSubIntegerBias(t *testing.T) {
+	rawValue := big.NewInt(1_000_000)
+	weight := big.NewInt(9000) // 10% below neutral
+
+	effectiveValue := new(big.Int).Mul(rawValue, weight)
+	effectiveValue.Div(effectiveValue, big.NewInt(relayWeightBasisPointsNeutral))
+
+	if want := big.NewInt(900_000); effectiveValue.Cmp(want) != 0 {
+		t.Fatalf("expected effective value %s, got %s", want.String(), effectiveValue.String())
+	}
+}
+
+// TestBidHubRelaysForBidAllFiltered verifies the bidHub half of the
+// all-filtered-out path: once every publishBid call for a block hash carried
+// a FilterReason, relaysForBid reports no attribution for it.
+// TestHandleGetHeaderAllRelaysFilteredReturns204 covers the handler-level
+// behavior this enables (204, not 502).
+func TestBidHubRelaysForBidAllFiltered(t *testing.T) {
+	hub := newBidHub()
+
+	hub.publishBid(bidEvent{Slot: 1, Relay: "relay-a", BlockHash: "0xabc", FilterReason: "signature_mismatch"})
+	hub.publishBid(bidEvent{Slot: 1, Relay: "relay-b", BlockHash: "0xabc", FilterReason: "zero_value"})
+
+	if relays := hub.relaysForBid(1, "0xabc"); len(relays) != 0 {
+		t.Fatalf("expected no relay attribution for a fully filtered block hash, got %v", relays)
+	}
+}
+
+func TestBidHubRelaysForBidAccepted(t *testing.T) {
+	hub := newBidHub()
+
+	hub.publishBid(bidEvent{Slot: 1, Relay: "relay-a", BlockHash: "0xabc", SignatureValid: true})
+	hub.publishBid(bidEvent{Slot: 1, Relay: "relay-b", BlockHash: "0xabc", SignatureValid: true})
+
+	relays := hub.relaysForBid(1, "0xabc")
+	if len(relays) != 2 {
+		t.Fatalf("expected both relays to be attributed to the accepted block hash, got %v", relays)
+	}
+}